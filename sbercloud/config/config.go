@@ -0,0 +1,136 @@
+// Package config provides the SberCloud-specific wrapper around
+// huaweicloud.Config that the native resources under sbercloud/services use
+// instead of importing huaweicloud.Config directly. It exists so that
+// per-region, per-service endpoint overrides can be layered on top of the
+// shared huaweicloud auth/client plumbing without hard-coding a single
+// "hc.sbercloud.ru" cloud suffix for every client.
+package config
+
+import (
+	"fmt"
+	"sync"
+
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud"
+)
+
+// regionEndpoints catalogs the per-region, per-service API hosts that
+// SberCloud exposes. Only the regions SberCloud currently operates in are
+// listed; unknown regions fall back to a URL derived from CloudDomain.
+var regionEndpoints = map[string]map[string]string{
+	"ru-moscow-1": {
+		"obs": "https://obs.ru-moscow-1.hc.sbercloud.ru",
+		"ecs": "https://ecs.ru-moscow-1.hc.sbercloud.ru",
+		"evs": "https://evs.ru-moscow-1.hc.sbercloud.ru",
+		"cce": "https://cce.ru-moscow-1.hc.sbercloud.ru",
+	},
+}
+
+// cloudDomains catalogs the root API domain suffix (the value huaweicloud.Config
+// derives per-service endpoints from, as "https://<service>.<region>.<domain>")
+// for each region SberCloud operates in, so that configureProvider no longer
+// hard-codes a single "hc.sbercloud.ru" literal regardless of region. Regions
+// absent from this map fall back to defaultCloudDomain.
+var cloudDomains = map[string]string{
+	"ru-moscow-1": "hc.sbercloud.ru",
+}
+
+// defaultCloudDomain is the root API domain suffix used for regions not
+// listed in cloudDomains.
+const defaultCloudDomain = "hc.sbercloud.ru"
+
+// CloudDomain returns the root API domain suffix huaweicloud.Config.Cloud
+// should be set to for the given region, resolved from the per-region
+// catalog instead of a single hard-coded literal.
+func CloudDomain(region string) string {
+	if domain, ok := cloudDomains[region]; ok && domain != "" {
+		return domain
+	}
+	return defaultCloudDomain
+}
+
+// Config wraps huaweicloud.Config with the endpoint catalog and per-service
+// accessors that sbercloud/services resources use to talk to SberCloud
+// directly, instead of going through huaweicloud.* resource implementations.
+type Config struct {
+	*huaweicloud.Config
+
+	// Endpoints holds any user-supplied overrides from the provider's
+	// "endpoints" block, keyed by service name. These take priority over
+	// both regionEndpoints and the huaweicloud-derived Cloud endpoint.
+	Endpoints map[string]string
+}
+
+// ServiceEndpoint returns the API endpoint the named service should be
+// reached at for the Config's region, honoring Endpoints overrides first,
+// then the SberCloud region catalog, and finally falling back to letting
+// the caller derive one from the wrapped huaweicloud.Config.
+func (c *Config) ServiceEndpoint(service string) (string, bool) {
+	if c.Endpoints != nil {
+		if endpoint, ok := c.Endpoints[service]; ok && endpoint != "" {
+			return endpoint, true
+		}
+	}
+
+	if endpoints, ok := regionEndpoints[c.Region]; ok {
+		if endpoint, ok := endpoints[service]; ok {
+			return endpoint, true
+		}
+	}
+
+	return "", false
+}
+
+// NewConfig wraps an already-authenticated huaweicloud.Config so that
+// sbercloud/services resources can be built against it. A nil endpoints map
+// falls back to whatever overrides were registered for this specific
+// hwConfig instance via RegisterEndpoints.
+func NewConfig(hwConfig *huaweicloud.Config, endpoints map[string]string) (*Config, error) {
+	if hwConfig == nil {
+		return nil, fmt.Errorf("a loaded huaweicloud.Config is required")
+	}
+
+	if endpoints == nil {
+		endpoints = EndpointsFor(hwConfig)
+	}
+
+	return &Config{
+		Config:    hwConfig,
+		Endpoints: endpoints,
+	}, nil
+}
+
+// endpointsByConfig holds the "endpoints" block overrides registered by
+// RegisterEndpoints, keyed by the *huaweicloud.Config pointer they belong to
+// rather than a single process-wide value. Terraform can configure more than
+// one sbercloud provider instance per run (e.g. via "alias" for multi-region
+// setups), and each instance's overrides must stay isolated from the others.
+//
+// Entries are never removed: a *huaweicloud.Config is configured once per
+// provider instance and lives for the lifetime of the plugin process, which
+// is the assumption this relies on. That holds for normal `terraform
+// plan`/`apply` runs, but a long-running host that calls configureProvider
+// repeatedly in the same process (e.g. a test harness exercising many
+// provider instances) will leak one entry per call.
+var (
+	endpointsMu       sync.RWMutex
+	endpointsByConfig = map[*huaweicloud.Config]map[string]string{}
+)
+
+// RegisterEndpoints associates the service -> URL overrides from a single
+// provider instance's "endpoints" block with the huaweicloud.Config that
+// instance configured, so that sbercloud/services resources built from that
+// same Config (via NewConfig) honor them without needing the overrides
+// threaded through every ConfigureFunc and resource signature by hand.
+func RegisterEndpoints(hwConfig *huaweicloud.Config, endpoints map[string]string) {
+	endpointsMu.Lock()
+	defer endpointsMu.Unlock()
+	endpointsByConfig[hwConfig] = endpoints
+}
+
+// EndpointsFor returns the overrides registered for hwConfig via
+// RegisterEndpoints, or nil if none were registered.
+func EndpointsFor(hwConfig *huaweicloud.Config) map[string]string {
+	endpointsMu.RLock()
+	defer endpointsMu.RUnlock()
+	return endpointsByConfig[hwConfig]
+}
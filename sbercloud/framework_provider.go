@@ -0,0 +1,254 @@
+package sbercloud
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	fwprovider "github.com/hashicorp/terraform-plugin-framework/provider"
+	fwschema "github.com/hashicorp/terraform-plugin-framework/provider/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud"
+)
+
+// frameworkProvider is the terraform-plugin-framework counterpart to the
+// SDKv2 Provider defined in provider.go. The two are combined behind a
+// single protocol-6 server in main.go via tf6muxserver, so resources can be
+// migrated to the framework one at a time instead of in a single rewrite.
+type frameworkProvider struct {
+	config *huaweicloud.Config
+}
+
+// NewFrameworkProvider returns the terraform-plugin-framework provider.Provider
+// implementation that is muxed alongside the SDKv2 Provider.
+func NewFrameworkProvider() fwprovider.Provider {
+	return &frameworkProvider{}
+}
+
+// frameworkProviderModel must stay attribute-for-attribute identical to the
+// SDKv2 Schema in Provider(): tf6muxserver requires every muxed backend to
+// return the same provider-level schema for the single shared
+// provider "sbercloud" {} block, so it carries a field for every SDKv2
+// attribute, including security_token/assume_role_*/kubeconfig/
+// credentials_secret/endpoints.
+type frameworkProviderModel struct {
+	AccessKey         types.String `tfsdk:"access_key"`
+	SecretKey         types.String `tfsdk:"secret_key"`
+	AuthURL           types.String `tfsdk:"auth_url"`
+	Region            types.String `tfsdk:"region"`
+	UserName          types.String `tfsdk:"user_name"`
+	ProjectName       types.String `tfsdk:"project_name"`
+	Password          types.String `tfsdk:"password"`
+	AccountName       types.String `tfsdk:"account_name"`
+	Insecure          types.Bool   `tfsdk:"insecure"`
+	SecurityToken     types.String `tfsdk:"security_token"`
+	AssumeRoleAgency  types.String `tfsdk:"assume_role_agency"`
+	AssumeRoleDomain  types.String `tfsdk:"assume_role_domain"`
+	Kubeconfig        types.String `tfsdk:"kubeconfig"`
+	CredentialsSecret types.List   `tfsdk:"credentials_secret"`
+	Endpoints         types.Map    `tfsdk:"endpoints"`
+}
+
+// credentialsSecretModel mirrors a single element of the "credentials_secret"
+// list, matching credentialsSecretSchema's SDKv2 fields.
+type credentialsSecretModel struct {
+	Namespace        types.String `tfsdk:"namespace"`
+	Name             types.String `tfsdk:"name"`
+	AccessKeyKey     types.String `tfsdk:"access_key_key"`
+	SecretKeyKey     types.String `tfsdk:"secret_key_key"`
+	SecurityTokenKey types.String `tfsdk:"security_token_key"`
+}
+
+func (p *frameworkProvider) Metadata(_ context.Context, _ fwprovider.MetadataRequest, resp *fwprovider.MetadataResponse) {
+	resp.TypeName = "sbercloud"
+}
+
+func (p *frameworkProvider) Schema(_ context.Context, _ fwprovider.SchemaRequest, resp *fwprovider.SchemaResponse) {
+	resp.Schema = fwschema.Schema{
+		Attributes: map[string]fwschema.Attribute{
+			"access_key": fwschema.StringAttribute{
+				Optional:    true,
+				Description: descriptions["access_key"],
+			},
+			"secret_key": fwschema.StringAttribute{
+				Optional:    true,
+				Description: descriptions["secret_key"],
+			},
+			"auth_url": fwschema.StringAttribute{
+				Optional:    true,
+				Description: descriptions["auth_url"],
+			},
+			"region": fwschema.StringAttribute{
+				Optional:    true,
+				Description: descriptions["region"],
+			},
+			"user_name": fwschema.StringAttribute{
+				Optional:    true,
+				Description: descriptions["user_name"],
+			},
+			"project_name": fwschema.StringAttribute{
+				Optional:    true,
+				Description: descriptions["project_name"],
+			},
+			"password": fwschema.StringAttribute{
+				Optional:    true,
+				Sensitive:   true,
+				Description: descriptions["password"],
+			},
+			"account_name": fwschema.StringAttribute{
+				Optional:    true,
+				Description: descriptions["account_name"],
+			},
+			"insecure": fwschema.BoolAttribute{
+				Optional:    true,
+				Description: descriptions["insecure"],
+			},
+			"security_token": fwschema.StringAttribute{
+				Optional:    true,
+				Description: descriptions["security_token"],
+			},
+			"assume_role_agency": fwschema.StringAttribute{
+				Optional:    true,
+				Description: descriptions["assume_role_agency"],
+			},
+			"assume_role_domain": fwschema.StringAttribute{
+				Optional:    true,
+				Description: descriptions["assume_role_domain"],
+			},
+			"kubeconfig": fwschema.StringAttribute{
+				Optional:    true,
+				Description: descriptions["kubeconfig"],
+			},
+			"credentials_secret": fwschema.ListNestedAttribute{
+				Optional:    true,
+				Description: descriptions["credentials_secret"],
+				NestedObject: fwschema.NestedAttributeObject{
+					Attributes: map[string]fwschema.Attribute{
+						"namespace": fwschema.StringAttribute{
+							Required:    true,
+							Description: "The namespace of the Kubernetes Secret holding the SberCloud credentials.",
+						},
+						"name": fwschema.StringAttribute{
+							Required:    true,
+							Description: "The name of the Kubernetes Secret holding the SberCloud credentials.",
+						},
+						"access_key_key": fwschema.StringAttribute{
+							Optional:    true,
+							Description: "The Secret data key holding the SberCloud access key.",
+						},
+						"secret_key_key": fwschema.StringAttribute{
+							Optional:    true,
+							Description: "The Secret data key holding the SberCloud secret key.",
+						},
+						"security_token_key": fwschema.StringAttribute{
+							Optional:    true,
+							Description: "The Secret data key holding a SberCloud security token, if any.",
+						},
+					},
+				},
+			},
+			"endpoints": fwschema.MapAttribute{
+				ElementType: types.StringType,
+				Optional:    true,
+				Description: descriptions["endpoints"],
+			},
+		},
+	}
+}
+
+// Configure builds the huaweicloud.Config via the same buildSberCloudConfig
+// helper configureProvider uses, so that vpc_eip/networking_secgroup_rule
+// see assume-role temporary credentials and Kubernetes-Secret-resolved
+// credentials exactly like the SDKv2-backed resources do. The endpoints
+// overrides are registered against that Config as well, but vpc_eip and
+// networking_secgroup_rule build their networking clients without
+// consulting them. The SDKv2 and framework servers are still configured
+// independently by Terraform core, so the *decoding* of this provider's
+// config is necessarily duplicated, but the authentication/credential logic
+// itself is shared.
+func (p *frameworkProvider) Configure(ctx context.Context, req fwprovider.ConfigureRequest, resp *fwprovider.ConfigureResponse) {
+	var data frameworkProviderModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	in := providerConfigInput{
+		AccessKey:        data.AccessKey.ValueString(),
+		SecretKey:        data.SecretKey.ValueString(),
+		AuthURL:          data.AuthURL.ValueString(),
+		Region:           data.Region.ValueString(),
+		UserName:         data.UserName.ValueString(),
+		ProjectName:      data.ProjectName.ValueString(),
+		Password:         data.Password.ValueString(),
+		AccountName:      data.AccountName.ValueString(),
+		Insecure:         data.Insecure.ValueBool(),
+		SecurityToken:    data.SecurityToken.ValueString(),
+		AssumeRoleAgency: data.AssumeRoleAgency.ValueString(),
+		AssumeRoleDomain: data.AssumeRoleDomain.ValueString(),
+		Kubeconfig:       data.Kubeconfig.ValueString(),
+	}
+
+	if !data.CredentialsSecret.IsNull() && !data.CredentialsSecret.IsUnknown() {
+		var secrets []credentialsSecretModel
+		resp.Diagnostics.Append(data.CredentialsSecret.ElementsAs(ctx, &secrets, false)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		if len(secrets) > 0 {
+			secret := secrets[0]
+			accessKeyKey := secret.AccessKeyKey.ValueString()
+			if accessKeyKey == "" {
+				accessKeyKey = "access_key"
+			}
+			secretKeyKey := secret.SecretKeyKey.ValueString()
+			if secretKeyKey == "" {
+				secretKeyKey = "secret_key"
+			}
+			securityTokenKey := secret.SecurityTokenKey.ValueString()
+			if securityTokenKey == "" {
+				securityTokenKey = "security_token"
+			}
+
+			in.CredentialsSecret = &credentialsSecretInput{
+				Namespace:        secret.Namespace.ValueString(),
+				Name:             secret.Name.ValueString(),
+				AccessKeyKey:     accessKeyKey,
+				SecretKeyKey:     secretKeyKey,
+				SecurityTokenKey: securityTokenKey,
+			}
+		}
+	}
+
+	if !data.Endpoints.IsNull() && !data.Endpoints.IsUnknown() {
+		endpoints := map[string]string{}
+		resp.Diagnostics.Append(data.Endpoints.ElementsAs(ctx, &endpoints, false)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		in.Endpoints = endpoints
+	} else {
+		in.Endpoints = map[string]string{}
+	}
+
+	config, err := buildSberCloudConfig(in)
+	if err != nil {
+		resp.Diagnostics.AddError("Unable to authenticate with SberCloud", err.Error())
+		return
+	}
+
+	p.config = config
+	resp.ResourceData = p.config
+	resp.DataSourceData = p.config
+}
+
+func (p *frameworkProvider) Resources(_ context.Context) []func() resource.Resource {
+	return []func() resource.Resource{
+		NewVpcEIPResource,
+		NewNetworkingSecGroupRuleResource,
+	}
+}
+
+func (p *frameworkProvider) DataSources(_ context.Context) []func() datasource.DataSource {
+	return nil
+}
@@ -0,0 +1,138 @@
+package sbercloud
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/rest"
+	"k8s.io/client-go/tools/clientcmd"
+)
+
+// secretCredentials holds the SberCloud credential fields resolved from a
+// Kubernetes Secret.
+type secretCredentials struct {
+	AccessKey     string
+	SecretKey     string
+	SecurityToken string
+}
+
+// buildKubernetesClientset resolves a kubeconfig the same way the
+// Kubernetes remote-state backend does: an explicit kubeconfig file path,
+// then the KUBECONFIG env var, then ~/.kube/config,
+// falling back to in-cluster config when none of those are set. Any
+// exec-plugin auth declared in the resolved kubeconfig is honored by
+// clientcmd itself.
+func buildKubernetesClientset(kubeconfigPath string) (*kubernetes.Clientset, error) {
+	restConfig, err := loadKubernetesRestConfig(kubeconfigPath)
+	if err != nil {
+		return nil, err
+	}
+
+	return kubernetes.NewForConfig(restConfig)
+}
+
+func loadKubernetesRestConfig(kubeconfigPath string) (*rest.Config, error) {
+	if kubeconfigPath == "" {
+		if envPath := os.Getenv("KUBECONFIG"); envPath != "" {
+			kubeconfigPath = envPath
+		}
+	}
+
+	if kubeconfigPath == "" {
+		if home, err := os.UserHomeDir(); err == nil {
+			if candidate := filepath.Join(home, ".kube", "config"); fileExists(candidate) {
+				kubeconfigPath = candidate
+			}
+		}
+	}
+
+	if kubeconfigPath == "" {
+		restConfig, err := rest.InClusterConfig()
+		if err != nil {
+			return nil, fmt.Errorf("no kubeconfig was found and in-cluster config is unavailable: %s", err)
+		}
+		return restConfig, nil
+	}
+
+	loadingRules := clientcmd.NewDefaultClientConfigLoadingRules()
+	loadingRules.ExplicitPath = kubeconfigPath
+
+	return clientcmd.NewNonInteractiveDeferredLoadingClientConfig(loadingRules, &clientcmd.ConfigOverrides{}).ClientConfig()
+}
+
+func fileExists(path string) bool {
+	_, err := os.Stat(path)
+	return err == nil
+}
+
+// fetchCredentialsSecret reads the named Kubernetes Secret and extracts the
+// SberCloud AK/SK/token keyed by the accessKeyKey/secretKeyKey/tokenKey
+// fields of the "credentials_secret" provider block.
+func fetchCredentialsSecret(kubeconfigPath, namespace, name, accessKeyKey, secretKeyKey, tokenKey string) (*secretCredentials, error) {
+	clientset, err := buildKubernetesClientset(kubeconfigPath)
+	if err != nil {
+		return nil, fmt.Errorf("error building Kubernetes client for credentials_secret: %s", err)
+	}
+
+	secret, err := clientset.CoreV1().Secrets(namespace).Get(context.Background(), name, metav1.GetOptions{})
+	if err != nil {
+		return nil, fmt.Errorf("error reading Secret %s/%s: %s", namespace, name, err)
+	}
+
+	creds := &secretCredentials{
+		AccessKey: string(secret.Data[accessKeyKey]),
+		SecretKey: string(secret.Data[secretKeyKey]),
+	}
+	if tokenKey != "" {
+		creds.SecurityToken = string(secret.Data[tokenKey])
+	}
+
+	if creds.AccessKey == "" || creds.SecretKey == "" {
+		return nil, fmt.Errorf("Secret %s/%s is missing %q and/or %q", namespace, name, accessKeyKey, secretKeyKey)
+	}
+
+	return creds, nil
+}
+
+// credentialsSecretSchema returns the "credentials_secret" provider block
+// schema, used in place of inline access_key/secret_key when SberCloud
+// credentials are rotated through a Kubernetes Secret.
+func credentialsSecretSchema() *schema.Resource {
+	return &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"namespace": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "The namespace of the Kubernetes Secret holding the SberCloud credentials.",
+			},
+			"name": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "The name of the Kubernetes Secret holding the SberCloud credentials.",
+			},
+			"access_key_key": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Default:     "access_key",
+				Description: "The Secret data key holding the SberCloud access key.",
+			},
+			"secret_key_key": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Default:     "secret_key",
+				Description: "The Secret data key holding the SberCloud secret key.",
+			},
+			"security_token_key": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Default:     "security_token",
+				Description: "The Secret data key holding a SberCloud security token, if any.",
+			},
+		},
+	}
+}
@@ -1,10 +1,20 @@
 package sbercloud
 
 import (
+	"fmt"
+
 	"github.com/hashicorp/terraform-plugin-sdk/helper/mutexkv"
 	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/terraform"
+	"github.com/huaweicloud/golangsdk/openstack/identity/v3/tokens"
 	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud"
+
+	sbercloudconfig "github.com/velp/terraform-provider-sbercloud/sbercloud/config"
+	"github.com/velp/terraform-provider-sbercloud/sbercloud/services/cce"
+	"github.com/velp/terraform-provider-sbercloud/sbercloud/services/ecs"
+	"github.com/velp/terraform-provider-sbercloud/sbercloud/services/evs"
+	"github.com/velp/terraform-provider-sbercloud/sbercloud/services/obs"
+	"github.com/velp/terraform-provider-sbercloud/sbercloud/services/secretsmanager"
 )
 
 // This is a global MutexKV for use within this plugin.
@@ -88,6 +98,51 @@ func Provider() terraform.ResourceProvider {
 				DefaultFunc: schema.EnvDefaultFunc("SBC_INSECURE", false),
 				Description: descriptions["insecure"],
 			},
+
+			"security_token": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				DefaultFunc: schema.EnvDefaultFunc("SBC_SECURITY_TOKEN", ""),
+				Description: descriptions["security_token"],
+			},
+
+			"assume_role_agency": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				DefaultFunc:  schema.EnvDefaultFunc("SBC_ASSUME_ROLE_AGENCY", ""),
+				Description:  descriptions["assume_role_agency"],
+				RequiredWith: []string{"assume_role_domain"},
+			},
+
+			"assume_role_domain": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				DefaultFunc:  schema.EnvDefaultFunc("SBC_ASSUME_ROLE_DOMAIN", ""),
+				Description:  descriptions["assume_role_domain"],
+				RequiredWith: []string{"assume_role_agency"},
+			},
+
+			"kubeconfig": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				DefaultFunc: schema.EnvDefaultFunc("SBC_KUBECONFIG", ""),
+				Description: descriptions["kubeconfig"],
+			},
+
+			"credentials_secret": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				MaxItems:    1,
+				Description: descriptions["credentials_secret"],
+				Elem:        credentialsSecretSchema(),
+			},
+
+			"endpoints": {
+				Type:        schema.TypeMap,
+				Optional:    true,
+				Description: descriptions["endpoints"],
+				Elem:        &schema.Schema{Type: schema.TypeString},
+			},
 		},
 
 		DataSourcesMap: map[string]*schema.Resource{
@@ -96,6 +151,8 @@ func Provider() terraform.ResourceProvider {
 			"sbercloud_vpc_subnet":       huaweicloud.DataSourceVpcSubnetV1(),
 			"sbercloud_vpc_subnet_ids":   huaweicloud.DataSourceVpcSubnetIdsV1(),
 			"sbercloud_vpc_route":        huaweicloud.DataSourceVPCRouteV2(),
+
+			"sbercloud_secretsmanager_secret_version": secretsmanager.DataSourceSecretsManagerSecretVersion(),
 		},
 
 		ResourcesMap: map[string]*schema.Resource{
@@ -106,12 +163,23 @@ func Provider() terraform.ResourceProvider {
 			"sbercloud_identity_group_v3":            huaweicloud.ResourceIdentityGroupV3(),
 			"sbercloud_identity_group_membership_v3": huaweicloud.ResourceIdentityGroupMembershipV3(),
 			"sbercloud_vpc":                          huaweicloud.ResourceVirtualPrivateCloudV1(),
-			"sbercloud_vpc_eip":                      huaweicloud.ResourceVpcEIPV1(),
 			"sbercloud_vpc_route":                    huaweicloud.ResourceVPCRouteV2(),
 			"sbercloud_vpc_peering_connection":       huaweicloud.ResourceVpcPeeringConnectionV2(),
 			"sbercloud_vpc_subnet":                   huaweicloud.ResourceVpcSubnetV1(),
 			"sbercloud_networking_secgroup":          huaweicloud.ResourceNetworkingSecGroupV2(),
-			"sbercloud_networking_secgroup_rule":     huaweicloud.ResourceNetworkingSecGroupRuleV2(),
+			// sbercloud_vpc_eip and sbercloud_networking_secgroup_rule have
+			// been ported to the terraform-plugin-framework provider in
+			// framework_provider.go and are served from there instead.
+
+			// Native resources under sbercloud/services talk to SberCloud
+			// directly via golangsdk rather than re-exporting huaweicloud.*.
+			"sbercloud_obs_bucket":       obs.ResourceObsBucket(),
+			"sbercloud_compute_instance": ecs.ResourceComputeInstance(),
+			"sbercloud_evs_volume":       evs.ResourceEvsVolume(),
+			"sbercloud_cce_cluster":      cce.ResourceCceCluster(),
+
+			"sbercloud_secretsmanager_secret":         secretsmanager.ResourceSecretsManagerSecret(),
+			"sbercloud_secretsmanager_secret_version": secretsmanager.ResourceSecretsManagerSecretVersion(),
 		},
 	}
 
@@ -145,37 +213,188 @@ func init() {
 		"account_name": "The name of the Account to login with.",
 
 		"insecure": "Trust self-signed certificates.",
+
+		"security_token": "Security token to authenticate with a temporary AK/SK.",
+
+		"assume_role_agency": "The name of the IAM agency to assume for obtaining temporary credentials.",
+
+		"assume_role_domain": "The name of the domain that owns the IAM agency to assume.",
+
+		"kubeconfig": "Path to a kubeconfig file to use when resolving credentials_secret. Falls back to KUBECONFIG, ~/.kube/config, then in-cluster config.",
+
+		"credentials_secret": "Resolve access_key/secret_key/security_token from a Kubernetes Secret instead of supplying them inline.",
+
+		"endpoints": "A map of service name to custom API endpoint, for air-gapped stacks, staging environments, or mock servers.",
 	}
 }
 
-func configureProvider(d *schema.ResourceData, terraformVersion string) (interface{}, error) {
-	var project_name string
+// providerConfigInput is the plain-Go-types input to buildSberCloudConfig,
+// populated independently by configureProvider (from *schema.ResourceData)
+// and frameworkProvider.Configure (from frameworkProviderModel) so that both
+// muxed backends authenticate, resolve credentials_secret/assume-role, and
+// register endpoint overrides the exact same way.
+type providerConfigInput struct {
+	AccessKey         string
+	SecretKey         string
+	AuthURL           string
+	Region            string
+	UserName          string
+	ProjectName       string
+	Password          string
+	AccountName       string
+	Insecure          bool
+	SecurityToken     string
+	AssumeRoleAgency  string
+	AssumeRoleDomain  string
+	Kubeconfig        string
+	CredentialsSecret *credentialsSecretInput
+	Endpoints         map[string]string
+	TerraformVersion  string
+}
+
+// credentialsSecretInput mirrors a single "credentials_secret" block,
+// regardless of whether it was decoded from the SDKv2 or framework schema.
+type credentialsSecretInput struct {
+	Namespace        string
+	Name             string
+	AccessKeyKey     string
+	SecretKeyKey     string
+	SecurityTokenKey string
+}
+
+// buildSberCloudConfig authenticates against SberCloud IAM and returns the
+// huaweicloud.Config shared by every resource, SDKv2 and framework alike.
+// It resolves credentials_secret (if set), loads/validates the resulting
+// AK/SK, registers endpoints overrides against the returned Config, and
+// finally swaps in assume-role temporary credentials (if configured) -
+// doing this in one place keeps the two muxed backends from disagreeing on
+// which requests' features (chunk0-1, chunk0-4, chunk0-6) actually apply.
+func buildSberCloudConfig(in providerConfigInput) (*huaweicloud.Config, error) {
+	projectName := in.ProjectName
+	if projectName == "" {
+		projectName = in.Region
+	}
 
-	// Use region as project_name if it's not set
-	if v, ok := d.GetOk("project_name"); ok && v.(string) != "" {
-		project_name = v.(string)
-	} else {
-		project_name = d.Get("region").(string)
+	accessKey := in.AccessKey
+	secretKey := in.SecretKey
+	securityToken := in.SecurityToken
+
+	if in.CredentialsSecret != nil {
+		creds, err := fetchCredentialsSecret(
+			in.Kubeconfig,
+			in.CredentialsSecret.Namespace,
+			in.CredentialsSecret.Name,
+			in.CredentialsSecret.AccessKeyKey,
+			in.CredentialsSecret.SecretKeyKey,
+			in.CredentialsSecret.SecurityTokenKey,
+		)
+		if err != nil {
+			return nil, err
+		}
+
+		accessKey = creds.AccessKey
+		secretKey = creds.SecretKey
+		securityToken = creds.SecurityToken
 	}
 
 	config := huaweicloud.Config{
-		AccessKey:        d.Get("access_key").(string),
-		SecretKey:        d.Get("secret_key").(string),
-		DomainName:       d.Get("account_name").(string),
-		IdentityEndpoint: d.Get("auth_url").(string),
-		Insecure:         d.Get("insecure").(bool),
-		Password:         d.Get("password").(string),
-		Region:           d.Get("region").(string),
-		TenantName:       project_name,
-		Username:         d.Get("user_name").(string),
-		TerraformVersion: terraformVersion,
-		Cloud:            "hc.sbercloud.ru",
+		AccessKey:        accessKey,
+		SecretKey:        secretKey,
+		DomainName:       in.AccountName,
+		IdentityEndpoint: in.AuthURL,
+		Insecure:         in.Insecure,
+		Password:         in.Password,
+		Region:           in.Region,
+		TenantName:       projectName,
+		Username:         in.UserName,
+		TerraformVersion: in.TerraformVersion,
+		Cloud:            sbercloudconfig.CloudDomain(in.Region),
 		RegionClient:     true,
+		SecurityToken:    securityToken,
 	}
 
 	if err := config.LoadAndValidate(); err != nil {
 		return nil, err
 	}
 
+	sbercloudconfig.RegisterEndpoints(&config, in.Endpoints)
+
+	if in.AssumeRoleAgency != "" {
+		if err := assumeRole(&config, in.AssumeRoleAgency, in.AssumeRoleDomain, in.Endpoints); err != nil {
+			return nil, err
+		}
+	}
+
 	return &config, nil
 }
+
+func configureProvider(d *schema.ResourceData, terraformVersion string) (interface{}, error) {
+	in := providerConfigInput{
+		AccessKey:        d.Get("access_key").(string),
+		SecretKey:        d.Get("secret_key").(string),
+		AuthURL:          d.Get("auth_url").(string),
+		Region:           d.Get("region").(string),
+		UserName:         d.Get("user_name").(string),
+		ProjectName:      d.Get("project_name").(string),
+		Password:         d.Get("password").(string),
+		AccountName:      d.Get("account_name").(string),
+		Insecure:         d.Get("insecure").(bool),
+		SecurityToken:    d.Get("security_token").(string),
+		AssumeRoleAgency: d.Get("assume_role_agency").(string),
+		AssumeRoleDomain: d.Get("assume_role_domain").(string),
+		Kubeconfig:       d.Get("kubeconfig").(string),
+		TerraformVersion: terraformVersion,
+	}
+
+	if v, ok := d.GetOk("credentials_secret"); ok {
+		secretBlock := v.([]interface{})[0].(map[string]interface{})
+		in.CredentialsSecret = &credentialsSecretInput{
+			Namespace:        secretBlock["namespace"].(string),
+			Name:             secretBlock["name"].(string),
+			AccessKeyKey:     secretBlock["access_key_key"].(string),
+			SecretKeyKey:     secretBlock["secret_key_key"].(string),
+			SecurityTokenKey: secretBlock["security_token_key"].(string),
+		}
+	}
+
+	endpoints := map[string]string{}
+	for service, v := range d.Get("endpoints").(map[string]interface{}) {
+		endpoints[service] = v.(string)
+	}
+	in.Endpoints = endpoints
+
+	return buildSberCloudConfig(in)
+}
+
+// assumeRole exchanges the primary AK/SK held by config for short-lived
+// AK/SK/security-token credentials scoped to the given IAM agency, following
+// the same assume-agency flow as SberCloud IAM's temporary-credential API.
+// The swapped-in credentials replace config's long-lived secret so that
+// every client built from config afterwards talks to the API as the agency.
+// endpoints is the provider instance's "endpoints" block overrides, so that
+// an "iam" override applies to the assume-role exchange itself rather than
+// just to clients built after configureProvider returns.
+func assumeRole(config *huaweicloud.Config, agency, domain string, endpoints map[string]string) error {
+	identityClient, err := config.IdentityV3Client(config.Region)
+	if err != nil {
+		return fmt.Errorf("error creating IAM client for assume-role: %s", err)
+	}
+	if endpoint, ok := endpoints["iam"]; ok && endpoint != "" {
+		identityClient.Endpoint = endpoint
+	}
+
+	creds, err := tokens.AssumeRoleAgency(identityClient, tokens.AssumeRoleAgencyOpts{
+		AgencyName:      agency,
+		DomainName:      domain,
+		DurationSeconds: 3600,
+	}).ExtractTemporaryCredentials()
+	if err != nil {
+		return fmt.Errorf("error assuming IAM agency %q in domain %q: %s", agency, domain, err)
+	}
+
+	config.AccessKey = creds.AccessKeyID
+	config.SecretKey = creds.SecretAccessKey
+	config.SecurityToken = creds.SecurityToken
+
+	return nil
+}
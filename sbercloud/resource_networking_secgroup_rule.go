@@ -0,0 +1,227 @@
+package sbercloud
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/huaweicloud/golangsdk"
+	"github.com/huaweicloud/golangsdk/openstack/networking/v2/extensions/security/rules"
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud"
+)
+
+// networkingSecGroupRuleResource is the terraform-plugin-framework port of
+// sbercloud_networking_secgroup_rule (previously
+// huaweicloud.ResourceNetworkingSecGroupRuleV2 from the SDKv2 provider).
+type networkingSecGroupRuleResource struct {
+	config *huaweicloud.Config
+}
+
+// NewNetworkingSecGroupRuleResource returns the resource.Resource
+// implementation for sbercloud_networking_secgroup_rule.
+func NewNetworkingSecGroupRuleResource() resource.Resource {
+	return &networkingSecGroupRuleResource{}
+}
+
+type networkingSecGroupRuleModel struct {
+	ID              types.String `tfsdk:"id"`
+	Region          types.String `tfsdk:"region"`
+	Direction       types.String `tfsdk:"direction"`
+	EtherType       types.String `tfsdk:"ethertype"`
+	Protocol        types.String `tfsdk:"protocol"`
+	PortRangeMin    types.Int64  `tfsdk:"port_range_min"`
+	PortRangeMax    types.Int64  `tfsdk:"port_range_max"`
+	RemoteIPPrefix  types.String `tfsdk:"remote_ip_prefix"`
+	RemoteGroupID   types.String `tfsdk:"remote_group_id"`
+	SecurityGroupID types.String `tfsdk:"security_group_id"`
+}
+
+func (r *networkingSecGroupRuleResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_networking_secgroup_rule"
+}
+
+func (r *networkingSecGroupRuleResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed: true,
+			},
+			"region": schema.StringAttribute{
+				Optional: true,
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"direction": schema.StringAttribute{
+				Required: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"ethertype": schema.StringAttribute{
+				Required: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"protocol": schema.StringAttribute{
+				Optional: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"port_range_min": schema.Int64Attribute{
+				Optional: true,
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.RequiresReplace(),
+				},
+			},
+			"port_range_max": schema.Int64Attribute{
+				Optional: true,
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.RequiresReplace(),
+				},
+			},
+			"remote_ip_prefix": schema.StringAttribute{
+				Optional: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"remote_group_id": schema.StringAttribute{
+				Optional: true,
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"security_group_id": schema.StringAttribute{
+				Required: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+		},
+	}
+}
+
+func (r *networkingSecGroupRuleResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+	r.config = req.ProviderData.(*huaweicloud.Config)
+}
+
+func (r *networkingSecGroupRuleResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan networkingSecGroupRuleModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	region := plan.Region.ValueString()
+	if region == "" {
+		region = r.config.Region
+	}
+
+	client, err := r.config.NetworkingV2Client(region)
+	if err != nil {
+		resp.Diagnostics.AddError("Unable to create SberCloud networking client", err.Error())
+		return
+	}
+
+	createOpts := rules.CreateOpts{
+		Direction:      plan.Direction.ValueString(),
+		EtherType:      plan.EtherType.ValueString(),
+		Protocol:       plan.Protocol.ValueString(),
+		PortRangeMin:   int(plan.PortRangeMin.ValueInt64()),
+		PortRangeMax:   int(plan.PortRangeMax.ValueInt64()),
+		RemoteIPPrefix: plan.RemoteIPPrefix.ValueString(),
+		RemoteGroupID:  plan.RemoteGroupID.ValueString(),
+		SecGroupID:     plan.SecurityGroupID.ValueString(),
+	}
+
+	rule, err := rules.Create(client, createOpts).Extract()
+	if err != nil {
+		resp.Diagnostics.AddError("Error creating sbercloud_networking_secgroup_rule", err.Error())
+		return
+	}
+
+	plan.ID = types.StringValue(rule.ID)
+	plan.Region = types.StringValue(region)
+	plan.RemoteGroupID = types.StringValue(rule.RemoteGroupID)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
+}
+
+func (r *networkingSecGroupRuleResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state networkingSecGroupRuleModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	client, err := r.config.NetworkingV2Client(state.Region.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Unable to create SberCloud networking client", err.Error())
+		return
+	}
+
+	rule, err := rules.Get(client, state.ID.ValueString()).Extract()
+	if err != nil {
+		if _, ok := err.(golangsdk.ErrDefault404); ok {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddError(fmt.Sprintf("Error reading sbercloud_networking_secgroup_rule %s", state.ID.ValueString()), err.Error())
+		return
+	}
+
+	state.Direction = types.StringValue(rule.Direction)
+	state.EtherType = types.StringValue(rule.EtherType)
+	state.Protocol = types.StringValue(rule.Protocol)
+	state.PortRangeMin = types.Int64Value(int64(rule.PortRangeMin))
+	state.PortRangeMax = types.Int64Value(int64(rule.PortRangeMax))
+	state.RemoteIPPrefix = types.StringValue(rule.RemoteIPPrefix)
+	state.RemoteGroupID = types.StringValue(rule.RemoteGroupID)
+	state.SecurityGroupID = types.StringValue(rule.SecGroupID)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, state)...)
+}
+
+// Update is a no-op: every attribute on sbercloud_networking_secgroup_rule
+// forces replacement, matching the SDKv2 resource it replaces.
+func (r *networkingSecGroupRuleResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan networkingSecGroupRuleModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
+}
+
+func (r *networkingSecGroupRuleResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state networkingSecGroupRuleModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	client, err := r.config.NetworkingV2Client(state.Region.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Unable to create SberCloud networking client", err.Error())
+		return
+	}
+
+	if err := rules.Delete(client, state.ID.ValueString()).ExtractErr(); err != nil {
+		resp.Diagnostics.AddError(fmt.Sprintf("Error deleting sbercloud_networking_secgroup_rule %s", state.ID.ValueString()), err.Error())
+	}
+}
+
+func (r *networkingSecGroupRuleResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
@@ -0,0 +1,223 @@
+package sbercloud
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/huaweicloud/golangsdk"
+	"github.com/huaweicloud/golangsdk/openstack/networking/v1/bandwidths"
+	"github.com/huaweicloud/golangsdk/openstack/networking/v1/eips"
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud"
+)
+
+// vpcEIPResource is the terraform-plugin-framework port of
+// sbercloud_vpc_eip (previously huaweicloud.ResourceVpcEIPV1 from the SDKv2
+// provider). It covers the common publicip+bandwidth attributes only.
+type vpcEIPResource struct {
+	config *huaweicloud.Config
+}
+
+// NewVpcEIPResource returns the resource.Resource implementation for
+// sbercloud_vpc_eip.
+func NewVpcEIPResource() resource.Resource {
+	return &vpcEIPResource{}
+}
+
+type vpcEIPModel struct {
+	ID            types.String `tfsdk:"id"`
+	Region        types.String `tfsdk:"region"`
+	Type          types.String `tfsdk:"type"`
+	Address       types.String `tfsdk:"address"`
+	PortID        types.String `tfsdk:"port_id"`
+	BandwidthName types.String `tfsdk:"bandwidth_name"`
+	BandwidthSize types.Int64  `tfsdk:"bandwidth_size"`
+}
+
+func (r *vpcEIPResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_vpc_eip"
+}
+
+func (r *vpcEIPResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed: true,
+			},
+			"region": schema.StringAttribute{
+				Optional: true,
+				Computed: true,
+			},
+			"type": schema.StringAttribute{
+				Required: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"address": schema.StringAttribute{
+				Computed: true,
+			},
+			"port_id": schema.StringAttribute{
+				Optional: true,
+			},
+			"bandwidth_name": schema.StringAttribute{
+				Required: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"bandwidth_size": schema.Int64Attribute{
+				Required: true,
+			},
+		},
+	}
+}
+
+func (r *vpcEIPResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+	r.config = req.ProviderData.(*huaweicloud.Config)
+}
+
+func (r *vpcEIPResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan vpcEIPModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	region := plan.Region.ValueString()
+	if region == "" {
+		region = r.config.Region
+	}
+
+	client, err := r.config.NetworkingV1Client(region)
+	if err != nil {
+		resp.Diagnostics.AddError("Unable to create SberCloud networking client", err.Error())
+		return
+	}
+
+	createOpts := eips.ApplyOpts{
+		IP: eips.PublicIpOpts{
+			Type: plan.Type.ValueString(),
+		},
+		Bandwidth: eips.BandwidthOpts{
+			Name:       plan.BandwidthName.ValueString(),
+			Size:       int(plan.BandwidthSize.ValueInt64()),
+			ShareType:  "PER",
+			ChargeMode: "traffic",
+		},
+	}
+
+	eip, err := eips.Apply(client, createOpts).Extract()
+	if err != nil {
+		resp.Diagnostics.AddError("Error creating sbercloud_vpc_eip", err.Error())
+		return
+	}
+
+	if portID := plan.PortID.ValueString(); portID != "" {
+		if _, err := eips.Update(client, eip.ID, eips.UpdateOpts{PortID: portID}).Extract(); err != nil {
+			resp.Diagnostics.AddError("Error associating sbercloud_vpc_eip with port", err.Error())
+			return
+		}
+	}
+
+	plan.ID = types.StringValue(eip.ID)
+	plan.Region = types.StringValue(region)
+	plan.Address = types.StringValue(eip.PublicAddress)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
+}
+
+func (r *vpcEIPResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state vpcEIPModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	client, err := r.config.NetworkingV1Client(state.Region.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Unable to create SberCloud networking client", err.Error())
+		return
+	}
+
+	eip, err := eips.Get(client, state.ID.ValueString()).Extract()
+	if err != nil {
+		if _, ok := err.(golangsdk.ErrDefault404); ok {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddError(fmt.Sprintf("Error reading sbercloud_vpc_eip %s", state.ID.ValueString()), err.Error())
+		return
+	}
+
+	state.Address = types.StringValue(eip.PublicAddress)
+	state.PortID = types.StringValue(eip.PortID)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, state)...)
+}
+
+func (r *vpcEIPResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan, state vpcEIPModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	client, err := r.config.NetworkingV1Client(state.Region.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Unable to create SberCloud networking client", err.Error())
+		return
+	}
+
+	if !plan.PortID.Equal(state.PortID) {
+		if _, err := eips.Update(client, state.ID.ValueString(), eips.UpdateOpts{PortID: plan.PortID.ValueString()}).Extract(); err != nil {
+			resp.Diagnostics.AddError("Error updating sbercloud_vpc_eip association", err.Error())
+			return
+		}
+	}
+
+	if !plan.BandwidthSize.Equal(state.BandwidthSize) {
+		bwOpts := bandwidths.UpdateOpts{Size: int(plan.BandwidthSize.ValueInt64())}
+		if _, err := bandwidths.Update(client, state.ID.ValueString(), bwOpts).Extract(); err != nil {
+			resp.Diagnostics.AddError("Error resizing sbercloud_vpc_eip bandwidth", err.Error())
+			return
+		}
+	}
+
+	plan.ID = state.ID
+	plan.Region = state.Region
+	plan.Address = state.Address
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
+}
+
+func (r *vpcEIPResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state vpcEIPModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	client, err := r.config.NetworkingV1Client(state.Region.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Unable to create SberCloud networking client", err.Error())
+		return
+	}
+
+	if err := eips.Delete(client, state.ID.ValueString()).ExtractErr(); err != nil {
+		resp.Diagnostics.AddError(fmt.Sprintf("Error deleting sbercloud_vpc_eip %s", state.ID.ValueString()), err.Error())
+	}
+}
+
+func (r *vpcEIPResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
@@ -0,0 +1,212 @@
+// Package cce implements sbercloud_cce_cluster directly against SberCloud's
+// CCE (Cloud Container Engine) API via golangsdk, instead of re-exporting
+// the huaweicloud-backed resource.
+package cce
+
+import (
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/huaweicloud/golangsdk"
+	"github.com/huaweicloud/golangsdk/openstack/cce/v3/clusters"
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud"
+
+	sbercloudconfig "github.com/velp/terraform-provider-sbercloud/sbercloud/config"
+)
+
+// ResourceCceCluster returns the schema.Resource for sbercloud_cce_cluster.
+func ResourceCceCluster() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceCceClusterCreate,
+		Read:   resourceCceClusterRead,
+		Delete: resourceCceClusterDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(30 * time.Minute),
+			Delete: schema.DefaultTimeout(30 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"region": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"cluster_type": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Default:  "VirtualMachine",
+				ForceNew: true,
+			},
+			"flavor_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"vpc_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"subnet_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"container_network_cidr": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"status": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func cceClient(meta interface{}, region string) (*golangsdk.ServiceClient, error) {
+	hwConfig := meta.(*huaweicloud.Config)
+
+	client, err := hwConfig.CceV3Client(region)
+	if err != nil {
+		return nil, err
+	}
+
+	cfg, err := sbercloudconfig.NewConfig(hwConfig, nil)
+	if err != nil {
+		return nil, err
+	}
+	if endpoint, ok := cfg.ServiceEndpoint("cce"); ok {
+		client.Endpoint = endpoint
+	}
+
+	return client, nil
+}
+
+func resourceCceClusterCreate(d *schema.ResourceData, meta interface{}) error {
+	region := d.Get("region").(string)
+	if region == "" {
+		region = meta.(*huaweicloud.Config).Region
+	}
+
+	client, err := cceClient(meta, region)
+	if err != nil {
+		return fmt.Errorf("error creating SberCloud CCE client: %s", err)
+	}
+
+	createOpts := clusters.CreateOpts{
+		Kind:       "Cluster",
+		ApiVersion: "v3",
+		Metadata: clusters.CreateMetaData{
+			Name: d.Get("name").(string),
+		},
+		Spec: clusters.Spec{
+			Type:        d.Get("cluster_type").(string),
+			Flavor:      d.Get("flavor_id").(string),
+			HostNetwork: clusters.HostNetworkSpec{VpcId: d.Get("vpc_id").(string), SubnetId: d.Get("subnet_id").(string)},
+			ContainerNetwork: clusters.ContainerNetworkSpec{
+				Mode: "overlay_l2",
+				Cidr: d.Get("container_network_cidr").(string),
+			},
+		},
+	}
+
+	log.Printf("[DEBUG] creating sbercloud_cce_cluster with options: %#v", createOpts)
+	cluster, err := clusters.Create(client, createOpts).Extract()
+	if err != nil {
+		return fmt.Errorf("error creating sbercloud_cce_cluster: %s", err)
+	}
+
+	d.SetId(cluster.Metadata.Id)
+	d.Set("region", region)
+
+	stateConf := &resource.StateChangeConf{
+		Pending:    []string{"Creating"},
+		Target:     []string{"Available"},
+		Refresh:    clusterStateRefreshFunc(client, cluster.Metadata.Id),
+		Timeout:    d.Timeout(schema.TimeoutCreate),
+		Delay:      15 * time.Second,
+		MinTimeout: 10 * time.Second,
+	}
+	if _, err := stateConf.WaitForState(); err != nil {
+		return fmt.Errorf("error waiting for sbercloud_cce_cluster %s to become available: %s", cluster.Metadata.Id, err)
+	}
+
+	return resourceCceClusterRead(d, meta)
+}
+
+func resourceCceClusterRead(d *schema.ResourceData, meta interface{}) error {
+	client, err := cceClient(meta, d.Get("region").(string))
+	if err != nil {
+		return fmt.Errorf("error creating SberCloud CCE client: %s", err)
+	}
+
+	cluster, err := clusters.Get(client, d.Id()).Extract()
+	if err != nil {
+		if _, ok := err.(golangsdk.ErrDefault404); ok {
+			log.Printf("[WARN] sbercloud_cce_cluster %s no longer exists", d.Id())
+			d.SetId("")
+			return nil
+		}
+		return fmt.Errorf("error reading sbercloud_cce_cluster %s: %s", d.Id(), err)
+	}
+
+	d.Set("name", cluster.Metadata.Name)
+	d.Set("cluster_type", cluster.Spec.Type)
+	d.Set("flavor_id", cluster.Spec.Flavor)
+	d.Set("status", cluster.Status.Phase)
+
+	return nil
+}
+
+func resourceCceClusterDelete(d *schema.ResourceData, meta interface{}) error {
+	client, err := cceClient(meta, d.Get("region").(string))
+	if err != nil {
+		return fmt.Errorf("error creating SberCloud CCE client: %s", err)
+	}
+
+	if err := clusters.Delete(client, d.Id()).ExtractErr(); err != nil {
+		return fmt.Errorf("error deleting sbercloud_cce_cluster %s: %s", d.Id(), err)
+	}
+
+	stateConf := &resource.StateChangeConf{
+		Pending:    []string{"Available", "Deleting"},
+		Target:     []string{"Deleted"},
+		Refresh:    clusterStateRefreshFunc(client, d.Id()),
+		Timeout:    d.Timeout(schema.TimeoutDelete),
+		Delay:      15 * time.Second,
+		MinTimeout: 10 * time.Second,
+	}
+	if _, err := stateConf.WaitForState(); err != nil {
+		return fmt.Errorf("error waiting for sbercloud_cce_cluster %s to be deleted: %s", d.Id(), err)
+	}
+
+	return nil
+}
+
+func clusterStateRefreshFunc(client *golangsdk.ServiceClient, id string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		cluster, err := clusters.Get(client, id).Extract()
+		if err != nil {
+			if _, ok := err.(golangsdk.ErrDefault404); ok {
+				return cluster, "Deleted", nil
+			}
+			return nil, "", err
+		}
+		return cluster, cluster.Status.Phase, nil
+	}
+}
@@ -0,0 +1,211 @@
+// Package ecs implements sbercloud_compute_instance directly against
+// SberCloud's ECS API via golangsdk, instead of re-exporting the
+// huaweicloud-backed resource.
+package ecs
+
+import (
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/huaweicloud/golangsdk"
+	"github.com/huaweicloud/golangsdk/openstack/compute/v2/servers"
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud"
+
+	sbercloudconfig "github.com/velp/terraform-provider-sbercloud/sbercloud/config"
+)
+
+// ResourceComputeInstance returns the schema.Resource for
+// sbercloud_compute_instance.
+func ResourceComputeInstance() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceComputeInstanceCreate,
+		Read:   resourceComputeInstanceRead,
+		Delete: resourceComputeInstanceDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(30 * time.Minute),
+			Delete: schema.DefaultTimeout(30 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"region": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"image_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"flavor_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"security_group_ids": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				ForceNew: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"network_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"availability_zone": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+			"access_ip_v4": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func computeClient(meta interface{}, region string) (*golangsdk.ServiceClient, error) {
+	hwConfig := meta.(*huaweicloud.Config)
+
+	client, err := hwConfig.ComputeV2Client(region)
+	if err != nil {
+		return nil, err
+	}
+
+	cfg, err := sbercloudconfig.NewConfig(hwConfig, nil)
+	if err != nil {
+		return nil, err
+	}
+	if endpoint, ok := cfg.ServiceEndpoint("ecs"); ok {
+		client.Endpoint = endpoint
+	}
+
+	return client, nil
+}
+
+func resourceComputeInstanceCreate(d *schema.ResourceData, meta interface{}) error {
+	region := d.Get("region").(string)
+	if region == "" {
+		region = meta.(*huaweicloud.Config).Region
+	}
+
+	client, err := computeClient(meta, region)
+	if err != nil {
+		return fmt.Errorf("error creating SberCloud compute client: %s", err)
+	}
+
+	var secGroups []string
+	for _, v := range d.Get("security_group_ids").(*schema.Set).List() {
+		secGroups = append(secGroups, v.(string))
+	}
+
+	createOpts := servers.CreateOpts{
+		Name:             d.Get("name").(string),
+		ImageRef:         d.Get("image_id").(string),
+		FlavorRef:        d.Get("flavor_id").(string),
+		SecurityGroups:   secGroups,
+		AvailabilityZone: d.Get("availability_zone").(string),
+		Networks: []servers.Network{
+			{UUID: d.Get("network_id").(string)},
+		},
+	}
+
+	log.Printf("[DEBUG] creating sbercloud_compute_instance with options: %#v", createOpts)
+	server, err := servers.Create(client, createOpts).Extract()
+	if err != nil {
+		return fmt.Errorf("error creating sbercloud_compute_instance: %s", err)
+	}
+
+	d.SetId(server.ID)
+	d.Set("region", region)
+
+	stateConf := &resource.StateChangeConf{
+		Pending:    []string{"BUILD"},
+		Target:     []string{"ACTIVE"},
+		Refresh:    serverStateRefreshFunc(client, server.ID),
+		Timeout:    d.Timeout(schema.TimeoutCreate),
+		Delay:      10 * time.Second,
+		MinTimeout: 5 * time.Second,
+	}
+	if _, err := stateConf.WaitForState(); err != nil {
+		return fmt.Errorf("error waiting for sbercloud_compute_instance %s to become active: %s", server.ID, err)
+	}
+
+	return resourceComputeInstanceRead(d, meta)
+}
+
+func resourceComputeInstanceRead(d *schema.ResourceData, meta interface{}) error {
+	client, err := computeClient(meta, d.Get("region").(string))
+	if err != nil {
+		return fmt.Errorf("error creating SberCloud compute client: %s", err)
+	}
+
+	server, err := servers.Get(client, d.Id()).Extract()
+	if err != nil {
+		if _, ok := err.(golangsdk.ErrDefault404); ok {
+			log.Printf("[WARN] sbercloud_compute_instance %s no longer exists", d.Id())
+			d.SetId("")
+			return nil
+		}
+		return fmt.Errorf("error reading sbercloud_compute_instance %s: %s", d.Id(), err)
+	}
+
+	d.Set("name", server.Name)
+	d.Set("availability_zone", server.AvailabilityZone)
+
+	return nil
+}
+
+func resourceComputeInstanceDelete(d *schema.ResourceData, meta interface{}) error {
+	client, err := computeClient(meta, d.Get("region").(string))
+	if err != nil {
+		return fmt.Errorf("error creating SberCloud compute client: %s", err)
+	}
+
+	if err := servers.Delete(client, d.Id()).ExtractErr(); err != nil {
+		return fmt.Errorf("error deleting sbercloud_compute_instance %s: %s", d.Id(), err)
+	}
+
+	stateConf := &resource.StateChangeConf{
+		Pending:    []string{"ACTIVE", "DELETING"},
+		Target:     []string{"DELETED"},
+		Refresh:    serverStateRefreshFunc(client, d.Id()),
+		Timeout:    d.Timeout(schema.TimeoutDelete),
+		Delay:      10 * time.Second,
+		MinTimeout: 5 * time.Second,
+	}
+	if _, err := stateConf.WaitForState(); err != nil {
+		return fmt.Errorf("error waiting for sbercloud_compute_instance %s to be deleted: %s", d.Id(), err)
+	}
+
+	return nil
+}
+
+func serverStateRefreshFunc(client *golangsdk.ServiceClient, id string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		server, err := servers.Get(client, id).Extract()
+		if err != nil {
+			if _, ok := err.(golangsdk.ErrDefault404); ok {
+				return server, "DELETED", nil
+			}
+			return nil, "", err
+		}
+		return server, server.Status, nil
+	}
+}
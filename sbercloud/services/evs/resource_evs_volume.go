@@ -0,0 +1,216 @@
+// Package evs implements sbercloud_evs_volume directly against SberCloud's
+// EVS (Elastic Volume Service) API via golangsdk, instead of re-exporting
+// the huaweicloud-backed resource.
+package evs
+
+import (
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/huaweicloud/golangsdk"
+	"github.com/huaweicloud/golangsdk/openstack/blockstorage/v2/volumes"
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud"
+
+	sbercloudconfig "github.com/velp/terraform-provider-sbercloud/sbercloud/config"
+)
+
+// ResourceEvsVolume returns the schema.Resource for sbercloud_evs_volume.
+func ResourceEvsVolume() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceEvsVolumeCreate,
+		Read:   resourceEvsVolumeRead,
+		Update: resourceEvsVolumeUpdate,
+		Delete: resourceEvsVolumeDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(15 * time.Minute),
+			Delete: schema.DefaultTimeout(15 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"region": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"size": {
+				Type:     schema.TypeInt,
+				Required: true,
+			},
+			"volume_type": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"availability_zone": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"status": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func evsClient(meta interface{}, region string) (*golangsdk.ServiceClient, error) {
+	hwConfig := meta.(*huaweicloud.Config)
+
+	client, err := hwConfig.BlockStorageV2Client(region)
+	if err != nil {
+		return nil, err
+	}
+
+	cfg, err := sbercloudconfig.NewConfig(hwConfig, nil)
+	if err != nil {
+		return nil, err
+	}
+	if endpoint, ok := cfg.ServiceEndpoint("evs"); ok {
+		client.Endpoint = endpoint
+	}
+
+	return client, nil
+}
+
+func resourceEvsVolumeCreate(d *schema.ResourceData, meta interface{}) error {
+	region := d.Get("region").(string)
+	if region == "" {
+		region = meta.(*huaweicloud.Config).Region
+	}
+
+	client, err := evsClient(meta, region)
+	if err != nil {
+		return fmt.Errorf("error creating SberCloud EVS client: %s", err)
+	}
+
+	createOpts := volumes.CreateOpts{
+		Name:             d.Get("name").(string),
+		Size:             d.Get("size").(int),
+		VolumeType:       d.Get("volume_type").(string),
+		AvailabilityZone: d.Get("availability_zone").(string),
+	}
+
+	log.Printf("[DEBUG] creating sbercloud_evs_volume with options: %#v", createOpts)
+	volume, err := volumes.Create(client, createOpts).Extract()
+	if err != nil {
+		return fmt.Errorf("error creating sbercloud_evs_volume: %s", err)
+	}
+
+	d.SetId(volume.ID)
+	d.Set("region", region)
+
+	stateConf := &resource.StateChangeConf{
+		Pending:    []string{"creating", "downloading"},
+		Target:     []string{"available"},
+		Refresh:    volumeStateRefreshFunc(client, volume.ID),
+		Timeout:    d.Timeout(schema.TimeoutCreate),
+		Delay:      5 * time.Second,
+		MinTimeout: 5 * time.Second,
+	}
+	if _, err := stateConf.WaitForState(); err != nil {
+		return fmt.Errorf("error waiting for sbercloud_evs_volume %s to become available: %s", volume.ID, err)
+	}
+
+	return resourceEvsVolumeRead(d, meta)
+}
+
+func resourceEvsVolumeRead(d *schema.ResourceData, meta interface{}) error {
+	client, err := evsClient(meta, d.Get("region").(string))
+	if err != nil {
+		return fmt.Errorf("error creating SberCloud EVS client: %s", err)
+	}
+
+	volume, err := volumes.Get(client, d.Id()).Extract()
+	if err != nil {
+		if _, ok := err.(golangsdk.ErrDefault404); ok {
+			log.Printf("[WARN] sbercloud_evs_volume %s no longer exists", d.Id())
+			d.SetId("")
+			return nil
+		}
+		return fmt.Errorf("error reading sbercloud_evs_volume %s: %s", d.Id(), err)
+	}
+
+	d.Set("name", volume.Name)
+	d.Set("size", volume.Size)
+	d.Set("volume_type", volume.VolumeType)
+	d.Set("availability_zone", volume.AvailabilityZone)
+	d.Set("status", volume.Status)
+
+	return nil
+}
+
+func resourceEvsVolumeUpdate(d *schema.ResourceData, meta interface{}) error {
+	client, err := evsClient(meta, d.Get("region").(string))
+	if err != nil {
+		return fmt.Errorf("error creating SberCloud EVS client: %s", err)
+	}
+
+	if d.HasChange("size") {
+		if err := volumes.ExtendSize(client, d.Id(), volumes.ExtendSizeOpts{
+			NewSize: d.Get("size").(int),
+		}).ExtractErr(); err != nil {
+			return fmt.Errorf("error resizing sbercloud_evs_volume %s: %s", d.Id(), err)
+		}
+	}
+
+	if d.HasChange("name") {
+		if _, err := volumes.Update(client, d.Id(), volumes.UpdateOpts{
+			Name: d.Get("name").(string),
+		}).Extract(); err != nil {
+			return fmt.Errorf("error renaming sbercloud_evs_volume %s: %s", d.Id(), err)
+		}
+	}
+
+	return resourceEvsVolumeRead(d, meta)
+}
+
+func resourceEvsVolumeDelete(d *schema.ResourceData, meta interface{}) error {
+	client, err := evsClient(meta, d.Get("region").(string))
+	if err != nil {
+		return fmt.Errorf("error creating SberCloud EVS client: %s", err)
+	}
+
+	if err := volumes.Delete(client, d.Id()).ExtractErr(); err != nil {
+		return fmt.Errorf("error deleting sbercloud_evs_volume %s: %s", d.Id(), err)
+	}
+
+	stateConf := &resource.StateChangeConf{
+		Pending:    []string{"available", "deleting"},
+		Target:     []string{"deleted"},
+		Refresh:    volumeStateRefreshFunc(client, d.Id()),
+		Timeout:    d.Timeout(schema.TimeoutDelete),
+		Delay:      5 * time.Second,
+		MinTimeout: 5 * time.Second,
+	}
+	if _, err := stateConf.WaitForState(); err != nil {
+		return fmt.Errorf("error waiting for sbercloud_evs_volume %s to be deleted: %s", d.Id(), err)
+	}
+
+	return nil
+}
+
+func volumeStateRefreshFunc(client *golangsdk.ServiceClient, id string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		volume, err := volumes.Get(client, id).Extract()
+		if err != nil {
+			if _, ok := err.(golangsdk.ErrDefault404); ok {
+				return volume, "deleted", nil
+			}
+			return nil, "", err
+		}
+		return volume, volume.Status, nil
+	}
+}
@@ -0,0 +1,159 @@
+// Package obs implements sbercloud_obs_bucket directly against SberCloud's
+// OBS (Object Storage Service) API, instead of re-exporting the
+// huaweicloud-backed resource. Unlike the other sbercloud/services
+// resources, OBS is accessed through golangsdk's dedicated obs.ObsClient
+// rather than a generic golangsdk.ServiceClient, since object storage uses
+// its own S3-compatible signing scheme.
+package obs
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/huaweicloud/golangsdk/openstack/obs"
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud"
+
+	sbercloudconfig "github.com/velp/terraform-provider-sbercloud/sbercloud/config"
+)
+
+// ResourceObsBucket returns the schema.Resource for sbercloud_obs_bucket.
+func ResourceObsBucket() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceObsBucketCreate,
+		Read:   resourceObsBucketRead,
+		Update: resourceObsBucketUpdate,
+		Delete: resourceObsBucketDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"region": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+			"bucket": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"acl": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Default:  "private",
+			},
+			"storage_class": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Default:  "STANDARD",
+				ForceNew: true,
+			},
+			"bucket_domain_name": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func obsClient(meta interface{}, region string) (*obs.ObsClient, error) {
+	hwConfig := meta.(*huaweicloud.Config)
+
+	cfg, err := sbercloudconfig.NewConfig(hwConfig, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	endpoint, ok := cfg.ServiceEndpoint("obs")
+	if !ok {
+		endpoint = fmt.Sprintf("https://obs.%s.%s", region, sbercloudconfig.CloudDomain(region))
+	}
+
+	return obs.New(hwConfig.AccessKey, hwConfig.SecretKey, endpoint,
+		obs.WithSecurityToken(hwConfig.SecurityToken))
+}
+
+func resourceObsBucketCreate(d *schema.ResourceData, meta interface{}) error {
+	region := d.Get("region").(string)
+	if region == "" {
+		region = meta.(*huaweicloud.Config).Region
+	}
+
+	client, err := obsClient(meta, region)
+	if err != nil {
+		return fmt.Errorf("error creating SberCloud OBS client: %s", err)
+	}
+
+	bucket := d.Get("bucket").(string)
+	input := &obs.CreateBucketInput{}
+	input.Bucket = bucket
+	input.ACL = obs.AclType(d.Get("acl").(string))
+	input.StorageClass = obs.StorageClassType(d.Get("storage_class").(string))
+	input.Location = region
+
+	log.Printf("[DEBUG] creating sbercloud_obs_bucket %s in %s", bucket, region)
+	if _, err := client.CreateBucket(input); err != nil {
+		return fmt.Errorf("error creating sbercloud_obs_bucket %s: %s", bucket, err)
+	}
+
+	d.SetId(bucket)
+	d.Set("region", region)
+
+	return resourceObsBucketRead(d, meta)
+}
+
+func resourceObsBucketRead(d *schema.ResourceData, meta interface{}) error {
+	region := d.Get("region").(string)
+	client, err := obsClient(meta, region)
+	if err != nil {
+		return fmt.Errorf("error creating SberCloud OBS client: %s", err)
+	}
+
+	bucket := d.Id()
+	if _, err := client.HeadBucket(bucket); err != nil {
+		if obsErr, ok := err.(obs.ObsError); ok && obsErr.StatusCode == 404 {
+			log.Printf("[WARN] sbercloud_obs_bucket %s no longer exists", bucket)
+			d.SetId("")
+			return nil
+		}
+		return fmt.Errorf("error reading sbercloud_obs_bucket %s: %s", bucket, err)
+	}
+
+	d.Set("bucket", bucket)
+	d.Set("bucket_domain_name", fmt.Sprintf("%s.obs.%s.%s", bucket, region, sbercloudconfig.CloudDomain(region)))
+
+	return nil
+}
+
+func resourceObsBucketUpdate(d *schema.ResourceData, meta interface{}) error {
+	client, err := obsClient(meta, d.Get("region").(string))
+	if err != nil {
+		return fmt.Errorf("error creating SberCloud OBS client: %s", err)
+	}
+
+	if d.HasChange("acl") {
+		input := &obs.SetBucketAclInput{Bucket: d.Id()}
+		input.ACL = obs.AclType(d.Get("acl").(string))
+		if _, err := client.SetBucketAcl(input); err != nil {
+			return fmt.Errorf("error updating sbercloud_obs_bucket %s ACL: %s", d.Id(), err)
+		}
+	}
+
+	return resourceObsBucketRead(d, meta)
+}
+
+func resourceObsBucketDelete(d *schema.ResourceData, meta interface{}) error {
+	client, err := obsClient(meta, d.Get("region").(string))
+	if err != nil {
+		return fmt.Errorf("error creating SberCloud OBS client: %s", err)
+	}
+
+	if _, err := client.DeleteBucket(d.Id()); err != nil {
+		return fmt.Errorf("error deleting sbercloud_obs_bucket %s: %s", d.Id(), err)
+	}
+
+	return nil
+}
@@ -0,0 +1,98 @@
+package secretsmanager
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/huaweicloud/golangsdk/openstack/dew/v1/secrets"
+)
+
+// DataSourceSecretsManagerSecretVersion returns the schema.Resource for the
+// sbercloud_secretsmanager_secret_version data source. Callers resolve a
+// version either by an explicit "version" number or by a stable "alias"
+// (e.g. "latest", "prod") configured on the parent secret's
+// version_aliases. At most one of the two may be set; with neither, the
+// "latest" version is read.
+func DataSourceSecretsManagerSecretVersion() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceSecretVersionRead,
+
+		Schema: map[string]*schema.Schema{
+			"region": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+			"secret_id": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"version": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				Computed:      true,
+				ConflictsWith: []string{"alias"},
+			},
+			"alias": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				ConflictsWith: []string{"version"},
+			},
+			"secret_data": {
+				Type:      schema.TypeString,
+				Computed:  true,
+				Sensitive: true,
+			},
+			"create_time": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"destroy_time": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func dataSourceSecretVersionRead(d *schema.ResourceData, meta interface{}) error {
+	region := d.Get("region").(string)
+	client, err := secretsManagerClient(meta, region)
+	if err != nil {
+		return fmt.Errorf("error creating SberCloud Secrets Manager client: %s", err)
+	}
+
+	secretID := d.Get("secret_id").(string)
+
+	versionID := d.Get("version").(string)
+	if alias, ok := d.GetOk("alias"); ok {
+		secret, err := secrets.Get(client, secretID).Extract()
+		if err != nil {
+			return fmt.Errorf("error reading sbercloud_secretsmanager_secret %s: %s", secretID, err)
+		}
+
+		resolved, ok := secret.VersionAliases[alias.(string)]
+		if !ok {
+			return fmt.Errorf("alias %q is not defined on sbercloud_secretsmanager_secret %s", alias.(string), secretID)
+		}
+		versionID = resolved
+	}
+
+	if versionID == "" {
+		versionID = "latest"
+	}
+
+	version, err := secrets.GetVersion(client, secretID, versionID).Extract()
+	if err != nil {
+		return fmt.Errorf("error reading version %q of sbercloud_secretsmanager_secret %s: %s", versionID, secretID, err)
+	}
+
+	d.SetId(fmt.Sprintf("%s/%s", secretID, version.VersionID))
+	d.Set("region", region)
+	d.Set("version", version.VersionID)
+	d.Set("secret_data", version.SecretData)
+	d.Set("create_time", version.CreateTime)
+	d.Set("destroy_time", version.DestroyTime)
+
+	return nil
+}
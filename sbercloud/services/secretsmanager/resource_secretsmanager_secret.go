@@ -0,0 +1,187 @@
+// Package secretsmanager implements the sbercloud_secretsmanager_secret and
+// sbercloud_secretsmanager_secret_version resources, plus the
+// sbercloud_secretsmanager_secret_version data source, against SberCloud's
+// DEW/CSMS secret storage.
+package secretsmanager
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/huaweicloud/golangsdk"
+	"github.com/huaweicloud/golangsdk/openstack/dew/v1/secrets"
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud"
+
+	sbercloudconfig "github.com/velp/terraform-provider-sbercloud/sbercloud/config"
+)
+
+// ResourceSecretsManagerSecret returns the schema.Resource for
+// sbercloud_secretsmanager_secret.
+func ResourceSecretsManagerSecret() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceSecretCreate,
+		Read:   resourceSecretRead,
+		Update: resourceSecretUpdate,
+		Delete: resourceSecretDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"region": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"description": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			// version_aliases maps a stable alias (e.g. "latest", "prod") to
+			// the numeric version it currently points at, so consumers can
+			// reference sbercloud_secretsmanager_secret_version by alias
+			// instead of pinning a specific version number.
+			"version_aliases": {
+				Type:     schema.TypeMap,
+				Optional: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+		},
+	}
+}
+
+func secretsManagerClient(meta interface{}, region string) (*golangsdk.ServiceClient, error) {
+	hwConfig := meta.(*huaweicloud.Config)
+
+	client, err := hwConfig.DewV1Client(region)
+	if err != nil {
+		return nil, err
+	}
+
+	cfg, err := sbercloudconfig.NewConfig(hwConfig, nil)
+	if err != nil {
+		return nil, err
+	}
+	if endpoint, ok := cfg.ServiceEndpoint("secretsmanager"); ok {
+		client.Endpoint = endpoint
+	}
+
+	return client, nil
+}
+
+func resourceSecretCreate(d *schema.ResourceData, meta interface{}) error {
+	region := d.Get("region").(string)
+	if region == "" {
+		region = meta.(*huaweicloud.Config).Region
+	}
+
+	client, err := secretsManagerClient(meta, region)
+	if err != nil {
+		return fmt.Errorf("error creating SberCloud Secrets Manager client: %s", err)
+	}
+
+	createOpts := secrets.CreateOpts{
+		Name:        d.Get("name").(string),
+		Description: d.Get("description").(string),
+	}
+
+	log.Printf("[DEBUG] creating sbercloud_secretsmanager_secret with options: %#v", createOpts)
+	secret, err := secrets.Create(client, createOpts).Extract()
+	if err != nil {
+		return fmt.Errorf("error creating sbercloud_secretsmanager_secret: %s", err)
+	}
+
+	d.SetId(secret.ID)
+	d.Set("region", region)
+
+	if err := setVersionAliases(client, secret.ID, d); err != nil {
+		return err
+	}
+
+	return resourceSecretRead(d, meta)
+}
+
+func resourceSecretRead(d *schema.ResourceData, meta interface{}) error {
+	client, err := secretsManagerClient(meta, d.Get("region").(string))
+	if err != nil {
+		return fmt.Errorf("error creating SberCloud Secrets Manager client: %s", err)
+	}
+
+	secret, err := secrets.Get(client, d.Id()).Extract()
+	if err != nil {
+		if _, ok := err.(golangsdk.ErrDefault404); ok {
+			log.Printf("[WARN] sbercloud_secretsmanager_secret %s no longer exists", d.Id())
+			d.SetId("")
+			return nil
+		}
+		return fmt.Errorf("error reading sbercloud_secretsmanager_secret %s: %s", d.Id(), err)
+	}
+
+	d.Set("name", secret.Name)
+	d.Set("description", secret.Description)
+	d.Set("version_aliases", secret.VersionAliases)
+
+	return nil
+}
+
+func resourceSecretUpdate(d *schema.ResourceData, meta interface{}) error {
+	client, err := secretsManagerClient(meta, d.Get("region").(string))
+	if err != nil {
+		return fmt.Errorf("error creating SberCloud Secrets Manager client: %s", err)
+	}
+
+	if d.HasChange("description") {
+		if _, err := secrets.Update(client, d.Id(), secrets.UpdateOpts{
+			Description: d.Get("description").(string),
+		}).Extract(); err != nil {
+			return fmt.Errorf("error updating sbercloud_secretsmanager_secret %s: %s", d.Id(), err)
+		}
+	}
+
+	if d.HasChange("version_aliases") {
+		if err := setVersionAliases(client, d.Id(), d); err != nil {
+			return err
+		}
+	}
+
+	return resourceSecretRead(d, meta)
+}
+
+func resourceSecretDelete(d *schema.ResourceData, meta interface{}) error {
+	client, err := secretsManagerClient(meta, d.Get("region").(string))
+	if err != nil {
+		return fmt.Errorf("error creating SberCloud Secrets Manager client: %s", err)
+	}
+
+	if err := secrets.Delete(client, d.Id()).ExtractErr(); err != nil {
+		return fmt.Errorf("error deleting sbercloud_secretsmanager_secret %s: %s", d.Id(), err)
+	}
+
+	return nil
+}
+
+func setVersionAliases(client *golangsdk.ServiceClient, secretID string, d *schema.ResourceData) error {
+	aliases := map[string]string{}
+	for alias, version := range d.Get("version_aliases").(map[string]interface{}) {
+		aliases[alias] = version.(string)
+	}
+
+	if len(aliases) == 0 {
+		return nil
+	}
+
+	if err := secrets.UpdateVersionAliases(client, secretID, secrets.UpdateVersionAliasesOpts{
+		VersionAliases: aliases,
+	}).ExtractErr(); err != nil {
+		return fmt.Errorf("error setting version_aliases on sbercloud_secretsmanager_secret %s: %s", secretID, err)
+	}
+
+	return nil
+}
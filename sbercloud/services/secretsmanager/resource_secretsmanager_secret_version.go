@@ -0,0 +1,126 @@
+package secretsmanager
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/huaweicloud/golangsdk"
+	"github.com/huaweicloud/golangsdk/openstack/dew/v1/secrets"
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud"
+)
+
+// ResourceSecretsManagerSecretVersion returns the schema.Resource for
+// sbercloud_secretsmanager_secret_version.
+func ResourceSecretsManagerSecretVersion() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceSecretVersionCreate,
+		Read:   resourceSecretVersionRead,
+		Delete: resourceSecretVersionDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"region": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+			"secret_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"secret_data": {
+				Type:      schema.TypeString,
+				Required:  true,
+				Sensitive: true,
+				ForceNew:  true,
+			},
+			"version_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"create_time": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"destroy_time": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceSecretVersionCreate(d *schema.ResourceData, meta interface{}) error {
+	region := d.Get("region").(string)
+	if region == "" {
+		region = meta.(*huaweicloud.Config).Region
+	}
+
+	client, err := secretsManagerClient(meta, region)
+	if err != nil {
+		return fmt.Errorf("error creating SberCloud Secrets Manager client: %s", err)
+	}
+
+	secretID := d.Get("secret_id").(string)
+
+	log.Printf("[DEBUG] creating a new version of sbercloud_secretsmanager_secret %s", secretID)
+	version, err := secrets.CreateVersion(client, secretID, secrets.CreateVersionOpts{
+		SecretData: d.Get("secret_data").(string),
+	}).Extract()
+	if err != nil {
+		return fmt.Errorf("error creating sbercloud_secretsmanager_secret_version for secret %s: %s", secretID, err)
+	}
+
+	d.SetId(fmt.Sprintf("%s/%s", secretID, version.VersionID))
+	d.Set("region", region)
+	d.Set("version_id", version.VersionID)
+	d.Set("create_time", version.CreateTime)
+
+	return resourceSecretVersionRead(d, meta)
+}
+
+func resourceSecretVersionRead(d *schema.ResourceData, meta interface{}) error {
+	client, err := secretsManagerClient(meta, d.Get("region").(string))
+	if err != nil {
+		return fmt.Errorf("error creating SberCloud Secrets Manager client: %s", err)
+	}
+
+	secretID := d.Get("secret_id").(string)
+	versionID := d.Get("version_id").(string)
+
+	version, err := secrets.GetVersion(client, secretID, versionID).Extract()
+	if err != nil {
+		if _, ok := err.(golangsdk.ErrDefault404); ok {
+			log.Printf("[WARN] version %s of sbercloud_secretsmanager_secret %s no longer exists", versionID, secretID)
+			d.SetId("")
+			return nil
+		}
+		return fmt.Errorf("error reading sbercloud_secretsmanager_secret_version %s: %s", d.Id(), err)
+	}
+
+	d.Set("create_time", version.CreateTime)
+	d.Set("destroy_time", version.DestroyTime)
+
+	return nil
+}
+
+func resourceSecretVersionDelete(d *schema.ResourceData, meta interface{}) error {
+	client, err := secretsManagerClient(meta, d.Get("region").(string))
+	if err != nil {
+		return fmt.Errorf("error creating SberCloud Secrets Manager client: %s", err)
+	}
+
+	secretID := d.Get("secret_id").(string)
+	versionID := d.Get("version_id").(string)
+
+	if err := secrets.DeleteVersion(client, secretID, versionID).ExtractErr(); err != nil {
+		return fmt.Errorf("error deleting sbercloud_secretsmanager_secret_version %s: %s", d.Id(), err)
+	}
+
+	return nil
+}